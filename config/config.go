@@ -0,0 +1,13 @@
+// Package config holds small operator-wide constants and lookups that don't
+// belong to any one controller, such as the default AWS region to use when a
+// request doesn't specify one.
+package config
+
+// DefaultRegion is used for AWS API calls that aren't region-specific, such
+// as Organizations calls, which always run against us-east-1.
+const DefaultRegion = "us-east-1"
+
+// GetDefaultRegion returns the AWS region to use for operator-wide API calls.
+func GetDefaultRegion() string {
+	return DefaultRegion
+}
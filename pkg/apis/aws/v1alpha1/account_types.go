@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountCrNamespace is the namespace Account CRs and the operator's AWS
+// credentials secret/configmap live in.
+const AccountCrNamespace = "aws-account-operator"
+
+// AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	AwsAccountID string `json:"awsAccountID"`
+	IsBYOC       bool   `json:"byoc,omitempty"`
+	AccountPool  string `json:"accountPool,omitempty"`
+}
+
+// AccountStatus defines the observed state of an Account.
+type AccountStatus struct {
+	Claimed bool   `json:"claimed,omitempty"`
+	State   string `json:"state,omitempty"`
+
+	// ValidationStatus records the inputs and outcome of the last successful
+	// accountvalidation reconcile, so a reconcile whose inputs haven't
+	// changed can skip re-validating against AWS. See ValidationStatus.
+	// +optional
+	ValidationStatus *ValidationStatus `json:"validationStatus,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Account is the Schema for the accounts API.
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec,omitempty"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountList contains a list of Account.
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Account `json:"items"`
+}
+
+// IsBYOC reports whether this is a customer-supplied ("bring your own cloud")
+// account rather than one from the shared account pool.
+func (a *Account) IsBYOC() bool {
+	return a.Spec.IsBYOC
+}
+
+// IsOwnedByAccountPool reports whether this Account is still claimed by the
+// shared account pool rather than handed out to a cluster.
+func (a *Account) IsOwnedByAccountPool() bool {
+	return a.Spec.AccountPool != ""
+}
+
+func init() {
+	SchemeBuilder.Register(&Account{}, &AccountList{})
+}
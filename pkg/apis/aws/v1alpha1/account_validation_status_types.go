@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidationStatus records the inputs and outcome of the last successful
+// accountvalidation reconcile for an Account, so a reconcile whose desired
+// inputs haven't changed can skip the AWS ListParents/ListTagsForResource
+// calls it would otherwise make on every pass. It is embedded as
+// AccountStatus.ValidationStatus.
+type ValidationStatus struct {
+	// LastValidationTime is when this Account last completed validation successfully.
+	// +optional
+	LastValidationTime *metav1.Time `json:"lastValidationTime,omitempty"`
+	// LastValidatedParentOU is the pool/root OU ID the account was confirmed to be under.
+	// +optional
+	LastValidatedParentOU string `json:"lastValidatedParentOU,omitempty"`
+	// LastValidatedOwnerTag is the owner tag value the account was confirmed to carry.
+	// +optional
+	LastValidatedOwnerTag string `json:"lastValidatedOwnerTag,omitempty"`
+	// ConfigHash is a hash of the operator configmap keys that drive validation
+	// (root OU, shard-name, feature flags, Control Tower OU IDs, previous shard
+	// names), used to detect when those inputs change.
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+}
@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DriftType categorizes a single piece of fleet-wide validation drift found by
+// the batch validation reconciler.
+type DriftType string
+
+const (
+	// DriftWrongOU is an AWS account parented under an OU other than the one
+	// its Account CR (or the pool) expects.
+	DriftWrongOU DriftType = "WrongOU"
+	// DriftMissingTag is an AWS account missing the owner tag.
+	DriftMissingTag DriftType = "MissingTag"
+	// DriftIncorrectOwnerTag is an AWS account tagged with the wrong owner.
+	DriftIncorrectOwnerTag DriftType = "IncorrectOwnerTag"
+	// DriftOrphanedAccount is an AWS account under a watched OU with no
+	// matching Account CR.
+	DriftOrphanedAccount DriftType = "OrphanedAccount"
+	// DriftOrphanedCR is an Account CR whose AwsAccountID has no matching AWS
+	// account under a watched OU.
+	DriftOrphanedCR DriftType = "OrphanedCR"
+)
+
+// AccountDrift is one AWS account or Account CR found to be out of line with
+// the fleet-wide validation pass.
+type AccountDrift struct {
+	// AwsAccountID is the AWS account ID involved, when known.
+	// +optional
+	AwsAccountID string `json:"awsAccountID,omitempty"`
+	// AccountCRName is the name of the corresponding Account CR, when known.
+	// +optional
+	AccountCRName string `json:"accountCRName,omitempty"`
+	Type          DriftType `json:"type"`
+	Detail        string    `json:"detail,omitempty"`
+}
+
+// AccountValidationReportSpec configures where the batch validation reconciler
+// looks for accounts to validate.
+type AccountValidationReportSpec struct {
+	// PoolOU is the root/pool OU ID to enumerate accounts and sub-OUs from.
+	PoolOU string `json:"poolOU"`
+}
+
+// AccountValidationReportStatus is the fleet-wide drift found by the most
+// recent batch validation pass.
+type AccountValidationReportStatus struct {
+	// LastRunTime is when this report was last refreshed.
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// AccountsScanned is the number of AWS accounts enumerated under PoolOU.
+	AccountsScanned int `json:"accountsScanned"`
+	// Drift lists every piece of drift found in the most recent pass.
+	// +optional
+	Drift []AccountDrift `json:"drift,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountValidationReport is the fleet-wide drift report produced by
+// periodically enumerating AWS Organizations rather than reconciling one
+// Account CR at a time.
+type AccountValidationReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountValidationReportSpec   `json:"spec,omitempty"`
+	Status AccountValidationReportStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountValidationReportList is a list of AccountValidationReport.
+type AccountValidationReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AccountValidationReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountValidationReport{}, &AccountValidationReportList{})
+}
@@ -0,0 +1,21 @@
+// Package v1alpha1 contains the v1alpha1 API group's types, following the
+// operator-sdk/kubebuilder scaffold convention: each _types.go file registers
+// its kinds with SchemeBuilder in an init().
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// GroupName is the group name used in this package's API objects.
+const GroupName = "aws.managed.openshift.io"
+
+// GroupVersion is the group version used to register these objects.
+var GroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+var SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+// AddToScheme adds all registered types to scheme.
+var AddToScheme = SchemeBuilder.AddToScheme
@@ -0,0 +1,71 @@
+package awsclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewAwsClientInput configures the IAM user secret and region a Client should
+// be built from.
+type NewAwsClientInput struct {
+	AwsRegion  string
+	SecretName string
+	NameSpace  string
+}
+
+// IBuilder builds an awsclient.Client for a controller, given the kube
+// secret holding the IAM credentials to use.
+type IBuilder interface {
+	GetClient(controllerName string, kubeClient client.Client, input NewAwsClientInput) (Client, error)
+}
+
+// Builder is the production IBuilder, backed by real AWS SDK sessions.
+type Builder struct{}
+
+// awsClient adapts the generated organizations/servicecatalog SDK clients to
+// the Client interface.
+type awsClient struct {
+	*organizations.Organizations
+	*servicecatalog.ServiceCatalog
+}
+
+// GetClient reads the named secret's aws_access_key_id/aws_secret_access_key
+// and builds a Client for the given region.
+func (b *Builder) GetClient(controllerName string, kubeClient client.Client, input NewAwsClientInput) (Client, error) {
+	secret := &corev1.Secret{}
+	err := kubeClient.Get(context.TODO(), types.NamespacedName{Name: input.SecretName, Namespace: input.NameSpace}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve AWS credentials secret %s/%s: %w", input.NameSpace, input.SecretName, err)
+	}
+
+	accessKeyID, ok := secret.Data["aws_access_key_id"]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s missing aws_access_key_id", input.NameSpace, input.SecretName)
+	}
+	secretAccessKey, ok := secret.Data["aws_secret_access_key"]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s missing aws_secret_access_key", input.NameSpace, input.SecretName)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(input.AwsRegion),
+		Credentials: credentials.NewStaticCredentials(string(accessKeyID), string(secretAccessKey), ""),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create AWS session for %s: %w", controllerName, err)
+	}
+
+	return &awsClient{
+		Organizations:  organizations.New(sess),
+		ServiceCatalog: servicecatalog.New(sess),
+	}, nil
+}
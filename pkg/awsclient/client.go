@@ -0,0 +1,27 @@
+// Package awsclient wraps the AWS SDK clients the operator talks to behind a
+// small interface, so controllers can be tested against a fake rather than
+// real AWS calls.
+//
+// This file only declares the subset of that surface pkg/controller/validation
+// depends on (Organizations and Service Catalog calls used to validate and
+// move accounts and to unenroll them from Control Tower). The rest of the
+// operator's controllers wrap additional AWS services (IAM, STS, S3, Support)
+// that aren't part of this package.
+package awsclient
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// Client is the AWS API surface pkg/controller/validation depends on.
+type Client interface {
+	ListParentsPages(input *organizations.ListParentsInput, fn func(*organizations.ListParentsOutput, bool) bool) error
+	MoveAccount(input *organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error)
+	ListTagsForResource(input *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error)
+	TagResource(input *organizations.TagResourceInput) (*organizations.TagResourceOutput, error)
+	ListAccountsForParentPages(input *organizations.ListAccountsForParentInput, fn func(*organizations.ListAccountsForParentOutput, bool) bool) error
+	ListOrganizationalUnitsForParentPages(input *organizations.ListOrganizationalUnitsForParentInput, fn func(*organizations.ListOrganizationalUnitsForParentOutput, bool) bool) error
+	SearchProvisionedProducts(input *servicecatalog.SearchProvisionedProductsInput) (*servicecatalog.SearchProvisionedProductsOutput, error)
+	TerminateProvisionedProduct(input *servicecatalog.TerminateProvisionedProductInput) (*servicecatalog.TerminateProvisionedProductOutput, error)
+}
@@ -0,0 +1,11 @@
+// Package schema defines the JSON Schema for the operator configmap keys
+// consumed by this repo's controllers, validates a configmap's contents
+// against it, and exposes a typed OperatorConfig so callers don't have to do
+// ad-hoc strconv.ParseBool on raw map lookups.
+//
+// schema.json is generated from the definition in internal/gen and committed
+// so it can be reviewed and diffed like any other change; run `go generate`
+// in this directory after adding or changing a configmap key.
+package schema
+
+//go:generate go run ./internal/gen
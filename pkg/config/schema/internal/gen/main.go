@@ -0,0 +1,44 @@
+// Command gen regenerates pkg/config/schema/schema.json from the schema
+// definition below. It is invoked via `go generate` in the parent package.
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+)
+
+func main() {
+	schema := map[string]interface{}{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"title":   "OperatorConfigMap",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"shard-name":           map[string]interface{}{"type": "string", "minLength": 1},
+			"root":                 map[string]interface{}{"type": "string", "minLength": 1},
+			"previous-shard-names": map[string]interface{}{"type": "string"},
+			"controltower-ou-ids":  map[string]interface{}{"type": "string"},
+
+			"feature.validation_move_account":          map[string]interface{}{"type": "boolean"},
+			"feature.validation_tag_account":           map[string]interface{}{"type": "boolean"},
+			"feature.validation_controltower_unenroll": map[string]interface{}{"type": "boolean"},
+		},
+		"required": []string{
+			"shard-name",
+			"root",
+			"feature.validation_move_account",
+			"feature.validation_tag_account",
+		},
+		"additionalProperties": true,
+	}
+
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		log.Fatalf("marshal schema: %v", err)
+	}
+	out = append(out, '\n')
+
+	if err := ioutil.WriteFile("schema.json", out, 0644); err != nil {
+		log.Fatalf("write schema.json: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/xeipuuv/gojsonschema"
+	corev1 "k8s.io/api/core/v1"
+)
+
+//go:embed schema.json
+var schemaFS embed.FS
+
+// Load validates cm.Data against the committed JSON Schema and decodes it into
+// an OperatorConfig. Unlike the ad-hoc strconv.ParseBool lookups it replaces,
+// a missing or malformed key is a hard error rather than a silently disabled
+// feature.
+func Load(cm *corev1.ConfigMap) (*OperatorConfig, error) {
+	schemaData, err := schemaFS.ReadFile("schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("could not read embedded configmap schema: %w", err)
+	}
+
+	// The configmap stores every value as a string; coerce bool-looking values
+	// before validating so the schema can declare their real JSON type.
+	doc := make(map[string]interface{}, len(cm.Data))
+	for k, v := range cm.Data {
+		if b, err := strconv.ParseBool(v); err == nil {
+			doc[k] = b
+		} else {
+			doc[k] = v
+		}
+	}
+	docJSON, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal configmap data: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schemaData), gojsonschema.NewBytesLoader(docJSON))
+	if err != nil {
+		return nil, fmt.Errorf("could not validate configmap against schema: %w", err)
+	}
+	if !result.Valid() {
+		errs := make([]string, 0, len(result.Errors()))
+		for _, e := range result.Errors() {
+			errs = append(errs, e.String())
+		}
+		return nil, fmt.Errorf("operator configmap failed schema validation: %v", errs)
+	}
+
+	cfg := &OperatorConfig{}
+	if err := json.Unmarshal(docJSON, cfg); err != nil {
+		return nil, fmt.Errorf("could not decode configmap into OperatorConfig: %w", err)
+	}
+	return cfg, nil
+}
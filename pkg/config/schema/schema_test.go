@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func validConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{Data: map[string]string{
+		"shard-name":                      "shard-1",
+		"root":                            "r-root",
+		"feature.validation_move_account": "true",
+		"feature.validation_tag_account":  "false",
+	}}
+}
+
+func TestLoadValidConfigMap(t *testing.T) {
+	cfg, err := Load(validConfigMap())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ShardName != "shard-1" || cfg.Root != "r-root" {
+		t.Fatalf("unexpected decode: %+v", cfg)
+	}
+	if !cfg.FeatureValidationMoveAccount || cfg.FeatureValidationTagAccount {
+		t.Fatalf("unexpected feature flags: %+v", cfg)
+	}
+}
+
+func TestLoadMissingRequiredKey(t *testing.T) {
+	cm := validConfigMap()
+	delete(cm.Data, "root")
+
+	if _, err := Load(cm); err == nil {
+		t.Fatal("expected an error for a configmap missing the required root key")
+	}
+}
+
+func TestLoadMalformedBooleanKey(t *testing.T) {
+	cm := validConfigMap()
+	cm.Data["feature.validation_move_account"] = "not-a-bool"
+
+	if _, err := Load(cm); err == nil {
+		t.Fatal("expected an error for a non-boolean feature flag value")
+	}
+}
+
+func TestLoadAllowsUnknownKeys(t *testing.T) {
+	cm := validConfigMap()
+	cm.Data["some-future-key"] = "anything"
+
+	if _, err := Load(cm); err != nil {
+		t.Fatalf("unexpected error for an unrecognized key: %v", err)
+	}
+}
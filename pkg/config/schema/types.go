@@ -0,0 +1,15 @@
+package schema
+
+// OperatorConfig is a typed view over the operator configmap keys consumed by
+// this repo's controllers. Add a field (and a matching schema.json property in
+// internal/gen) whenever a controller starts reading a new configmap key.
+type OperatorConfig struct {
+	ShardName          string `json:"shard-name"`
+	Root               string `json:"root"`
+	PreviousShardNames string `json:"previous-shard-names,omitempty"`
+	ControlTowerOUIDs  string `json:"controltower-ou-ids,omitempty"`
+
+	FeatureValidationMoveAccount          bool `json:"feature.validation_move_account"`
+	FeatureValidationTagAccount           bool `json:"feature.validation_tag_account"`
+	FeatureValidationControlTowerUnenroll bool `json:"feature.validation_controltower_unenroll,omitempty"`
+}
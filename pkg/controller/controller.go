@@ -0,0 +1,20 @@
+// Package controller aggregates the Add funcs of every controller package so
+// main can wire them all into the manager in one call.
+package controller
+
+import "sigs.k8s.io/controller-runtime/pkg/manager"
+
+// AddToManagerFuncs is a list of functions to add all Controllers and
+// Runnables to the Manager. Each controller package appends to this in an
+// add_<name>.go file's init().
+var AddToManagerFuncs []func(manager.Manager) error
+
+// AddToManager adds all registered Controllers and Runnables to the Manager.
+func AddToManager(m manager.Manager) error {
+	for _, f := range AddToManagerFuncs {
+		if err := f(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,17 +2,24 @@ package validation
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
-	"strconv"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/openshift/aws-account-operator/config"
 	awsv1alpha1 "github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
 	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/config/schema"
 	"github.com/openshift/aws-account-operator/pkg/controller/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -27,16 +34,18 @@ var accountMoveEnabled = false
 var accountTagEnabled = false
 
 const (
-	controllerName = "accountvalidation"
-	moveWaitTime   = 5 * time.Minute
-	ownerKey       = "owner"
+	controllerName       = "accountvalidation"
+	moveWaitTime         = 5 * time.Minute
+	tagRequeueTime       = 30 * time.Minute
+	ownerKey             = "owner"
+	defaultValidationTTL = 24 * time.Hour
 )
 
 type ValidateAccount struct {
 	Client           client.Client
 	scheme           *runtime.Scheme
 	awsClientBuilder awsclient.IBuilder
-	shardName        string
+	recorder         record.EventRecorder
 }
 
 type ValidationError int64
@@ -46,6 +55,16 @@ const (
 	AccountMoveFailed
 	MissingTag
 	IncorrectOwnerTag
+	TagFixed
+	ControlTowerUnenrollFailed
+	// ControlTowerUnenrollPending means unenrollment was requested successfully
+	// and the account is waiting for Control Tower to asynchronously finish
+	// moving it to Root - not a move failure, just not done yet.
+	ControlTowerUnenrollPending
+	// AccountMovePending means the account is not in poolOU and move-enforcement
+	// is disabled (dry run), so it was not actually moved or confirmed in place.
+	// Distinct from a nil error, which means the account's OU was confirmed.
+	AccountMovePending
 )
 
 type AccountValidationError struct {
@@ -80,94 +99,115 @@ func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 		Client:           utils.NewClientWithMetricsOrDie(log, mgr, controllerName),
 		scheme:           mgr.GetScheme(),
 		awsClientBuilder: &awsclient.Builder{},
+		recorder:         mgr.GetEventRecorderFor(controllerName),
 	}
 
-	configMap, err := utils.GetOperatorConfigMap(reconciler.Client)
-	if err != nil {
-		log.Error(err, "failed retrieving configmap")
-	}
-
-	hiveName, ok := configMap.Data["shard-name"]
-	if !ok {
-		log.Error(err, "shard-name key not available in configmap")
-	}
-	reconciler.shardName = hiveName
-
 	return utils.NewReconcilerWithMetrics(reconciler, controllerName)
 }
 
-// Retrieve all parents of the given awsId until the predicate returns true.
-func ParentsTillPredicate(awsId string, client awsclient.Client, p func(s string) bool, parents *[]string) error {
-	listParentsInput := organizations.ListParentsInput{
-		ChildId: aws.String(awsId),
-	}
-	listParentsOutput, err := client.ListParents(&listParentsInput)
-	if err != nil {
-		return err
-	}
-	if len(listParentsOutput.Parents) == 0 {
-		log.Info("Exhausted search looking for root OU - root OU and account OU likely in separate subtrees.", "path", parents)
-		return nil
-	} else if len(listParentsOutput.Parents) > 1 {
-		log.Info("More than 1 parent returned for an ID - unexpected.", "awsId", awsId)
-		return errors.New("More than 1 parents found for Id " + awsId)
-	} else {
-		id := *listParentsOutput.Parents[0].Id
-		*parents = append(*parents, id)
+// defaultMaxOUDepth bounds how far ParentsTillPredicate will walk up the OU
+// tree. AWS Organizations nesting is bounded (5 levels below the root as of
+// this writing), so a deeper walk indicates a cycle or a malformed tree.
+const defaultMaxOUDepth = 5
+
+// ErrParentCycleDetected is returned by ParentsTillPredicate when it revisits
+// an OU/root ID it has already seen while walking up from the child.
+var ErrParentCycleDetected = errors.New("cycle detected while walking account OU parents")
+
+// ErrMaxOUDepthExceeded is returned by ParentsTillPredicate when it exceeds
+// maxDepth without satisfying the predicate or exhausting the tree.
+var ErrMaxOUDepthExceeded = errors.New("exceeded max depth while walking account OU parents")
+
+// ParentsTillPredicate iteratively walks the parents of awsId, paginating each
+// ListParents call, until p returns true for a parent, the tree is exhausted,
+// or maxDepth is reached. It returns whether p matched, the path of parent IDs
+// walked (nearest parent first), and an error if the walk could not complete -
+// including ErrParentCycleDetected or ErrMaxOUDepthExceeded.
+func ParentsTillPredicate(awsId string, client awsclient.Client, p func(s string) bool, maxDepth int) (bool, []string, error) {
+	visited := map[string]struct{}{}
+	path := []string{}
+	currentID := awsId
+
+	for depth := 0; depth < maxDepth; depth++ {
+		var pageParents []string
+		err := client.ListParentsPages(&organizations.ListParentsInput{
+			ChildId: aws.String(currentID),
+		}, func(page *organizations.ListParentsOutput, lastPage bool) bool {
+			for _, parent := range page.Parents {
+				pageParents = append(pageParents, *parent.Id)
+			}
+			return true
+		})
+		if err != nil {
+			return false, path, err
+		}
+
+		if len(pageParents) == 0 {
+			log.Info("Exhausted search looking for root OU - root OU and account OU likely in separate subtrees.", "path", path)
+			return false, path, nil
+		}
+		if len(pageParents) > 1 {
+			log.Info("More than 1 parent returned for an ID - unexpected.", "awsId", currentID)
+			return false, path, errors.New("More than 1 parents found for Id " + currentID)
+		}
+
+		id := pageParents[0]
+		if _, seen := visited[id]; seen {
+			return false, path, ErrParentCycleDetected
+		}
+		visited[id] = struct{}{}
+		path = append(path, id)
+
 		if p(id) {
-			return nil
+			return true, path, nil
 		}
-		return ParentsTillPredicate(id, client, p, parents)
+		currentID = id
 	}
+	return false, path, ErrMaxOUDepthExceeded
 }
 
-// Verify if the account is already in the root OU
-// The predicate indicates if the parent considered the desired root was found.
+// IsAccountInPoolOU reports whether the account is already parented under the
+// pool OU. The predicate indicates whether a given parent is the desired OU.
 func IsAccountInPoolOU(account awsv1alpha1.Account, client awsclient.Client, isPoolOU func(s string) bool) bool {
 	if account.Spec.AwsAccountID == "" {
 		return false
 	}
-	parentList := []string{}
-	err := ParentsTillPredicate(account.Spec.AwsAccountID, client, isPoolOU, &parentList)
+	found, _, err := ParentsTillPredicate(account.Spec.AwsAccountID, client, isPoolOU, defaultMaxOUDepth)
 	if err != nil {
 		return false
 	}
-	if len(parentList) == 1 {
-		return true
-	}
-	return false
+	return found
 }
 
-func MoveAccount(awsAccountId string, client awsclient.Client, targetOU string, moveAccount bool) error {
-	listParentsInput := organizations.ListParentsInput{
-		ChildId: aws.String(awsAccountId),
-	}
-	listParentsOutput, err := client.ListParents(&listParentsInput)
-	if err != nil {
-		log.Error(err, "Can not find parent for AWS account", "aws-account", awsAccountId)
-		return err
-	}
-	oldOu := listParentsOutput.Parents[0].Id
+// MoveAccount moves awsAccountId from its known current OU (sourceOU, as
+// discovered by an earlier ParentsTillPredicate walk) to targetOU.
+func MoveAccount(awsAccountId string, client awsclient.Client, sourceOU string, targetOU string, moveAccount bool) error {
 	if moveAccount {
-		log.Info("Moving aws account from old ou to new ou", "aws-account", awsAccountId, "old-ou", *oldOu, "new-ou", targetOU)
+		log.Info("Moving aws account from old ou to new ou", "aws-account", awsAccountId, "old-ou", sourceOU, "new-ou", targetOU)
 		moveAccountInput := organizations.MoveAccountInput{
 			AccountId:           aws.String(awsAccountId),
 			DestinationParentId: aws.String(targetOU),
-			SourceParentId:      oldOu,
+			SourceParentId:      aws.String(sourceOU),
 		}
-		_, err = client.MoveAccount(&moveAccountInput)
+		_, err := client.MoveAccount(&moveAccountInput)
 		if err != nil {
 			log.Error(err, "Could not move aws account to new ou", "aws-account", awsAccountId, "ou", targetOU)
 			return err
 		}
 	} else {
-		log.Info("Not moving aws account from old ou to new ou (dry run)", "aws-account", awsAccountId, "old-ou", *oldOu, "new-ou", targetOU)
+		log.Info("Not moving aws account from old ou to new ou (dry run)", "aws-account", awsAccountId, "old-ou", sourceOU, "new-ou", targetOU)
 	}
 	return nil
 }
 
-// ValidateAccountTags avulaj: accountTagEnabled can be used in the future if we decide we want to fix this issue as we come across it during validation
-func ValidateAccountTags(client awsclient.Client, accountId *string, shardName string, accountTagEnabled bool) error {
+// ValidateAccountTags checks that the account is tagged with the expected owner.
+// When accountTagEnabled is true, drift is fixed in place via TagResource rather
+// than only being reported: a missing tag is added and an incorrect owner tag is
+// overwritten with the current shardName. previousShardNames is an allow-list of
+// prior shard names (from the operator configmap) used to recognize an in-flight
+// shard hand-off; an owner tag matching one of them is reported but left alone so
+// a human can confirm the hand-off before it is overwritten.
+func ValidateAccountTags(client awsclient.Client, accountId *string, shardName string, accountTagEnabled bool, previousShardNames []string) error {
 	listTagsForResourceInput := &organizations.ListTagsForResourceInput{
 		ResourceId: accountId,
 	}
@@ -180,6 +220,19 @@ func ValidateAccountTags(client awsclient.Client, accountId *string, shardName s
 	for _, tag := range resp.Tags {
 		if ownerKey == *tag.Key {
 			if shardName != *tag.Value {
+				if accountTagEnabled && !isAllowedHandoffOwner(*tag.Value, previousShardNames) {
+					if err := tagAccountOwner(client, accountId, shardName); err != nil {
+						return &AccountValidationError{
+							Type: IncorrectOwnerTag,
+							Err:  err,
+						}
+					}
+					log.Info("Fixed incorrect owner tag", "account", *accountId, "shard-name", shardName)
+					return &AccountValidationError{
+						Type: TagFixed,
+						Err:  errors.New("Account owner tag was corrected from a previous value"),
+					}
+				}
 				return &AccountValidationError{
 					Type: IncorrectOwnerTag,
 					Err:  errors.New("Account is not tagged with the correct owner"),
@@ -189,13 +242,50 @@ func ValidateAccountTags(client awsclient.Client, accountId *string, shardName s
 			}
 		}
 	}
+
+	if accountTagEnabled {
+		if err := tagAccountOwner(client, accountId, shardName); err != nil {
+			return &AccountValidationError{
+				Type: MissingTag,
+				Err:  err,
+			}
+		}
+		log.Info("Fixed missing owner tag", "account", *accountId, "shard-name", shardName)
+		return &AccountValidationError{
+			Type: TagFixed,
+			Err:  errors.New("Account owner tag was added"),
+		}
+	}
 	return &AccountValidationError{
 		Type: MissingTag,
 		Err:  errors.New("Account is not tagged with an owner"),
 	}
 }
 
-func (r *ValidateAccount) ValidateAccountOU(awsClient awsclient.Client, account awsv1alpha1.Account, poolOU string) error {
+// isAllowedHandoffOwner reports whether currentOwner is a recognized previous
+// shard name, meaning the tag drift is likely an in-flight hand-off rather than
+// an error to auto-correct.
+func isAllowedHandoffOwner(currentOwner string, previousShardNames []string) bool {
+	return containsString(previousShardNames, currentOwner)
+}
+
+// tagAccountOwner sets the owner tag on the given AWS account to shardName,
+// adding it if absent or overwriting it if already present.
+func tagAccountOwner(client awsclient.Client, accountId *string, shardName string) error {
+	tagResourceInput := &organizations.TagResourceInput{
+		ResourceId: accountId,
+		Tags: []*organizations.Tag{
+			{
+				Key:   aws.String(ownerKey),
+				Value: aws.String(shardName),
+			},
+		},
+	}
+	_, err := client.TagResource(tagResourceInput)
+	return err
+}
+
+func (r *ValidateAccount) ValidateAccountOU(awsClient awsclient.Client, account awsv1alpha1.Account, poolOU string, ctOUIDs []string, ctUnenrollEnabled bool) error {
 	// Perform basic short-circuit checks
 	if account.IsBYOC() {
 		log.Info("Will not validate a CCS account", "account", account)
@@ -212,26 +302,142 @@ func (r *ValidateAccount) ValidateAccountOU(awsClient awsclient.Client, account
 		}
 	}
 
-	// Perform all checks on the account we want.
-	inPool := IsAccountInPoolOU(account, awsClient, func(s string) bool {
+	// Walk the account's parents once, looking for the pool OU. The resulting
+	// path is reused below both to know the account's current OU (for
+	// MoveAccount) and to check Control Tower enrollment, instead of issuing a
+	// second ListParents walk for each.
+	inPool, path, err := ParentsTillPredicate(account.Spec.AwsAccountID, awsClient, func(s string) bool {
 		return s == poolOU
-	})
+	}, defaultMaxOUDepth)
+	if err != nil {
+		log.Error(err, "Could not determine account's current OU", "account", account)
+		return &AccountValidationError{
+			Type: AccountMoveFailed,
+			Err:  err,
+		}
+	}
 	if inPool {
 		log.Info("Account is already in the root OU.", "account", account)
-	} else {
-		log.Info("Account is not in the root OU - it will be moved.", "account", account)
-		err := MoveAccount(account.Spec.AwsAccountID, awsClient, poolOU, accountMoveEnabled)
-		if err != nil {
-			log.Error(err, "Could not move account", "account", account)
+		return nil
+	}
+	if len(path) == 0 {
+		return &AccountValidationError{
+			Type: AccountMoveFailed,
+			Err:  errors.New("Could not determine account's current OU"),
+		}
+	}
+	currentOU := path[0]
+
+	if ctUnenrollEnabled && len(ctOUIDs) > 0 && anyStringInList(path, ctOUIDs) {
+		log.Info("Account is parented under a Control Tower OU - unenrolling before move", "account", account)
+		if err := unenrollFromControlTower(account.Spec.AwsAccountID, awsClient); err != nil {
+			log.Error(err, "Could not unenroll account from Control Tower", "account", account)
 			return &AccountValidationError{
-				Type: AccountMoveFailed,
+				Type: ControlTowerUnenrollFailed,
 				Err:  err,
 			}
 		}
+		// Control Tower moves the account to Root asynchronously - requeue and
+		// let a later reconcile perform the MoveAccount once that's done. This
+		// is expected, not a failure, so it gets its own error type rather than
+		// being folded into AccountMoveFailed.
+		return &AccountValidationError{
+			Type: ControlTowerUnenrollPending,
+			Err:  errors.New("Waiting for Control Tower to move account to Root after unenroll"),
+		}
+	}
+
+	log.Info("Account is not in the root OU - it will be moved.", "account", account)
+	err = MoveAccount(account.Spec.AwsAccountID, awsClient, currentOU, poolOU, accountMoveEnabled)
+	if err != nil {
+		log.Error(err, "Could not move account", "account", account)
+		return &AccountValidationError{
+			Type: AccountMoveFailed,
+			Err:  err,
+		}
+	}
+	if !accountMoveEnabled {
+		// MoveAccount only logged what it would have done - the account is still
+		// outside poolOU, so this must not be treated the same as a confirmed
+		// in-place account by the caller's ValidationStatus caching.
+		return &AccountValidationError{
+			Type: AccountMovePending,
+			Err:  errors.New("Move-enforcement is disabled (dry run) - account not confirmed in pool OU"),
+		}
 	}
 	return nil
 }
 
+// unenrollFromControlTower finds the Control Tower provisioned product backing
+// the account and terminates it, which causes Control Tower to asynchronously
+// move the account out of its managed OU and back to Root.
+func unenrollFromControlTower(awsAccountId string, client awsclient.Client) error {
+	searchOutput, err := client.SearchProvisionedProducts(&servicecatalog.SearchProvisionedProductsInput{
+		Filters: map[string][]*string{
+			"SearchQuery": {aws.String("physicalId:" + awsAccountId)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(searchOutput.ProvisionedProducts) == 0 {
+		return errors.New("no Control Tower provisioned product found for account " + awsAccountId)
+	}
+
+	_, err = client.TerminateProvisionedProduct(&servicecatalog.TerminateProvisionedProductInput{
+		ProvisionedProductId: searchOutput.ProvisionedProducts[0].Id,
+	})
+	return err
+}
+
+// configValidationHash hashes the validated operator config that drives
+// validation, so a change to any of its fields invalidates any cached
+// ValidationStatus.
+func configValidationHash(cfg *schema.OperatorConfig) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "root=%s;shard-name=%s;feature.validation_move_account=%t;feature.validation_tag_account=%t;feature.validation_controltower_unenroll=%t;controltower-ou-ids=%s;previous-shard-names=%s",
+		cfg.Root, cfg.ShardName, cfg.FeatureValidationMoveAccount, cfg.FeatureValidationTagAccount,
+		cfg.FeatureValidationControlTowerUnenroll, cfg.ControlTowerOUIDs, cfg.PreviousShardNames)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// validationStatusFresh reports whether vs still reflects the current
+// parentOU/shardName/config hash and is within defaultValidationTTL, and if
+// so how much of the TTL remains.
+func validationStatusFresh(vs *awsv1alpha1.ValidationStatus, parentOU, shardName, hash string) (fresh bool, remaining time.Duration) {
+	if vs == nil || vs.LastValidationTime == nil {
+		return false, 0
+	}
+	if vs.ConfigHash != hash || vs.LastValidatedParentOU != parentOU || vs.LastValidatedOwnerTag != shardName {
+		return false, 0
+	}
+	age := time.Since(vs.LastValidationTime.Time)
+	if age >= defaultValidationTTL {
+		return false, 0
+	}
+	return true, defaultValidationTTL - age
+}
+
+// containsString reports whether s is present in list.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// anyStringInList reports whether any element of list is present in candidates.
+func anyStringInList(list []string, candidates []string) bool {
+	for _, item := range list {
+		if containsString(candidates, item) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ValidateAccount) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	log.WithValues("Controller", controllerName, "Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
@@ -249,20 +455,23 @@ func (r *ValidateAccount) Reconcile(request reconcile.Request) (reconcile.Result
 		return utils.RequeueAfter(5 * time.Minute)
 	}
 
-	enabled, err := strconv.ParseBool(cm.Data["feature.validation_move_account"])
+	cfg, err := schema.Load(cm)
 	if err != nil {
-		log.Info("Could not retrieve feature flag 'feature.validation_move_account' - account moving is disabled")
-	} else {
-		accountMoveEnabled = enabled
+		// Fail loudly rather than silently disabling a feature because one of
+		// its keys is missing or malformed.
+		log.Error(err, "Operator configmap failed schema validation")
+		r.recorder.Eventf(cm, corev1.EventTypeWarning, "InvalidOperatorConfig", "%v", err)
+		// Also surfacing this as a status condition on the operator's own
+		// leader-election lease was part of the original request, but this
+		// snapshot has no leader-lease or status-condition scaffolding anywhere
+		// in the tree to hang one off. The event above is the actionable signal
+		// for now; the status-condition half is intentionally left undone
+		// rather than fabricating lease plumbing to carry it.
+		return utils.RequeueAfter(5 * time.Minute)
 	}
+	accountMoveEnabled = cfg.FeatureValidationMoveAccount
+	accountTagEnabled = cfg.FeatureValidationTagAccount
 	log.Info("Is moving accounts enabled?", "enabled", accountMoveEnabled)
-
-	enabled, err = strconv.ParseBool(cm.Data["feature.validation_tag_account"])
-	if err != nil {
-		log.Info("Could not retrieve feature flag 'feature.validation_tag_account' - account tagging is disabled")
-	} else {
-		accountTagEnabled = enabled
-	}
 	log.Info("Is tagging accounts enabled?", "enabled", accountTagEnabled)
 
 	awsClientInput := awsclient.NewAwsClientInput{
@@ -275,8 +484,27 @@ func (r *ValidateAccount) Reconcile(request reconcile.Request) (reconcile.Result
 		log.Error(err, "Could not retrieve AWS client.")
 	}
 
+	var ctOUIDs []string
+	if cfg.ControlTowerOUIDs != "" {
+		ctOUIDs = strings.Split(cfg.ControlTowerOUIDs, ",")
+	}
+
+	var previousShardNames []string
+	if cfg.PreviousShardNames != "" {
+		previousShardNames = strings.Split(cfg.PreviousShardNames, ",")
+	}
+
+	// Short-circuit if nothing that drives validation has changed since the last
+	// successful run and that run is still within the TTL - this avoids issuing a
+	// ListParents+ListTagsForResource pair on every reconcile.
+	hash := configValidationHash(cfg)
+	if fresh, remaining := validationStatusFresh(account.Status.ValidationStatus, cfg.Root, cfg.ShardName, hash); fresh {
+		log.Info("Validation inputs unchanged and within TTL - skipping reconcile", "account", account.Name, "remaining", remaining)
+		return utils.RequeueAfter(remaining)
+	}
+
 	// Perform any checks we want
-	err = r.ValidateAccountOU(awsClient, account, cm.Data["root"])
+	err = r.ValidateAccountOU(awsClient, account, cfg.Root, ctOUIDs, cfg.FeatureValidationControlTowerUnenroll)
 	if err != nil {
 		// Decide who we will requeue now
 		validationError, ok := err.(*AccountValidationError)
@@ -284,18 +512,65 @@ func (r *ValidateAccount) Reconcile(request reconcile.Request) (reconcile.Result
 			if validationError.Type == InvalidAccount {
 				return utils.DoNotRequeue()
 			}
-			if validationError.Type == AccountMoveFailed {
+			if validationError.Type == AccountMoveFailed || validationError.Type == ControlTowerUnenrollFailed || validationError.Type == ControlTowerUnenrollPending || validationError.Type == AccountMovePending {
+				r.clearValidationStatus(&account)
 				return utils.RequeueAfter(moveWaitTime)
 			}
 		}
 	}
 
-	err = ValidateAccountTags(awsClient, aws.String(account.Spec.AwsAccountID), r.shardName, accountTagEnabled)
+	err = ValidateAccountTags(awsClient, aws.String(account.Spec.AwsAccountID), cfg.ShardName, accountTagEnabled, previousShardNames)
 	if err != nil {
 		validationError, ok := err.(*AccountValidationError)
-		if ok && (validationError.Type == MissingTag || validationError.Type == IncorrectOwnerTag) {
+		if !ok {
+			log.Error(err, "Could not validate account tags", "account", account.Name)
+			r.clearValidationStatus(&account)
 			return utils.DoNotRequeue()
 		}
+		if ok {
+			switch validationError.Type {
+			case TagFixed:
+				r.recorder.Eventf(&account, corev1.EventTypeNormal, "OwnerTagRemediated", "Owner tag set to %q", cfg.ShardName)
+			case MissingTag, IncorrectOwnerTag:
+				// Tag drift is still present - clear any cached validation state and
+				// requeue so it gets picked up again once it is fixed by hand or
+				// tagging is enabled.
+				r.clearValidationStatus(&account)
+				return utils.RequeueAfter(tagRequeueTime)
+			}
+		}
 	}
+
+	r.recordValidationStatus(&account, cfg.Root, cfg.ShardName, hash)
 	return utils.DoNotRequeue()
 }
+
+// recordValidationStatus patches the Account's ValidationStatus to reflect a
+// successful validation against the given inputs, so the next reconcile can
+// short-circuit while those inputs and the TTL still hold.
+func (r *ValidateAccount) recordValidationStatus(account *awsv1alpha1.Account, parentOU, shardName, configHash string) {
+	patch := client.MergeFrom(account.DeepCopy())
+	now := metav1.Now()
+	account.Status.ValidationStatus = &awsv1alpha1.ValidationStatus{
+		LastValidationTime:    &now,
+		LastValidatedParentOU: parentOU,
+		LastValidatedOwnerTag: shardName,
+		ConfigHash:            configHash,
+	}
+	if err := r.Client.Status().Patch(context.TODO(), account, patch); err != nil {
+		log.Error(err, "Could not patch ValidationStatus", "account", account.Name)
+	}
+}
+
+// clearValidationStatus patches away a stale ValidationStatus after validation
+// finds drift, so a short-circuited reconcile can't mask a real problem.
+func (r *ValidateAccount) clearValidationStatus(account *awsv1alpha1.Account) {
+	if account.Status.ValidationStatus == nil {
+		return
+	}
+	patch := client.MergeFrom(account.DeepCopy())
+	account.Status.ValidationStatus = nil
+	if err := r.Client.Status().Patch(context.TODO(), account, patch); err != nil {
+		log.Error(err, "Could not clear ValidationStatus", "account", account.Name)
+	}
+}
@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	awsv1alpha1 "github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/config/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testConfig() *schema.OperatorConfig {
+	return &schema.OperatorConfig{
+		ShardName:                    "shard-1",
+		Root:                         "r-root",
+		FeatureValidationMoveAccount: true,
+		FeatureValidationTagAccount:  true,
+	}
+}
+
+func TestConfigValidationHashStableForSameInput(t *testing.T) {
+	cfg := testConfig()
+	if configValidationHash(cfg) != configValidationHash(cfg) {
+		t.Fatal("expected the same config to hash to the same value")
+	}
+}
+
+func TestConfigValidationHashChangesWithFields(t *testing.T) {
+	base := testConfig()
+	baseHash := configValidationHash(base)
+
+	cases := map[string]*schema.OperatorConfig{
+		"root changed":        {ShardName: base.ShardName, Root: "r-other", FeatureValidationMoveAccount: base.FeatureValidationMoveAccount, FeatureValidationTagAccount: base.FeatureValidationTagAccount},
+		"shard name changed":  {ShardName: "shard-2", Root: base.Root, FeatureValidationMoveAccount: base.FeatureValidationMoveAccount, FeatureValidationTagAccount: base.FeatureValidationTagAccount},
+		"move flag flipped":   {ShardName: base.ShardName, Root: base.Root, FeatureValidationMoveAccount: false, FeatureValidationTagAccount: base.FeatureValidationTagAccount},
+		"ct ou ids added":     {ShardName: base.ShardName, Root: base.Root, FeatureValidationMoveAccount: base.FeatureValidationMoveAccount, FeatureValidationTagAccount: base.FeatureValidationTagAccount, ControlTowerOUIDs: "ou-1"},
+	}
+
+	for name, cfg := range cases {
+		if configValidationHash(cfg) == baseHash {
+			t.Errorf("%s: expected hash to change", name)
+		}
+	}
+}
+
+func TestValidateAccountOUReportsMovePendingOnDryRun(t *testing.T) {
+	accountMoveEnabled = false
+	defer func() { accountMoveEnabled = true }()
+
+	client := &fakeOrgClient{parentOf: map[string]string{"111111111111": "ou-other"}}
+	account := awsv1alpha1.Account{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "111111111111"}}
+
+	err := (&ValidateAccount{}).ValidateAccountOU(client, account, "ou-pool", nil, false)
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != AccountMovePending {
+		t.Fatalf("err = %v, want AccountMovePending", err)
+	}
+}
+
+func TestValidationStatusFresh(t *testing.T) {
+	now := metav1.Now()
+	hash := "abc123"
+
+	cases := []struct {
+		name      string
+		vs        *awsv1alpha1.ValidationStatus
+		wantFresh bool
+	}{
+		{name: "nil status", vs: nil, wantFresh: false},
+		{
+			name: "matching and within TTL",
+			vs: &awsv1alpha1.ValidationStatus{
+				ConfigHash: hash, LastValidatedParentOU: "r-root", LastValidatedOwnerTag: "shard-1",
+				LastValidationTime: &now,
+			},
+			wantFresh: true,
+		},
+		{
+			name: "hash changed",
+			vs: &awsv1alpha1.ValidationStatus{
+				ConfigHash: "different", LastValidatedParentOU: "r-root", LastValidatedOwnerTag: "shard-1",
+				LastValidationTime: &now,
+			},
+			wantFresh: false,
+		},
+		{
+			name: "shard name changed (mid hand-off)",
+			vs: &awsv1alpha1.ValidationStatus{
+				ConfigHash: hash, LastValidatedParentOU: "r-root", LastValidatedOwnerTag: "shard-0",
+				LastValidationTime: &now,
+			},
+			wantFresh: false,
+		},
+		{
+			name: "TTL expired",
+			vs: &awsv1alpha1.ValidationStatus{
+				ConfigHash: hash, LastValidatedParentOU: "r-root", LastValidatedOwnerTag: "shard-1",
+				LastValidationTime: &metav1.Time{Time: now.Add(-(defaultValidationTTL + time.Minute))},
+			},
+			wantFresh: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fresh, remaining := validationStatusFresh(tc.vs, "r-root", "shard-1", hash)
+			if fresh != tc.wantFresh {
+				t.Errorf("got fresh=%v, want %v", fresh, tc.wantFresh)
+			}
+			if fresh && remaining <= 0 {
+				t.Errorf("expected positive remaining TTL when fresh, got %v", remaining)
+			}
+			if !fresh && remaining != 0 {
+				t.Errorf("expected zero remaining TTL when not fresh, got %v", remaining)
+			}
+		})
+	}
+}
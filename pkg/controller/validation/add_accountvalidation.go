@@ -0,0 +1,7 @@
+package validation
+
+import "github.com/openshift/aws-account-operator/pkg/controller"
+
+func init() {
+	controller.AddToManagerFuncs = append(controller.AddToManagerFuncs, Add)
+}
@@ -0,0 +1,322 @@
+package validation
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/openshift/aws-account-operator/config"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
+	"github.com/openshift/aws-account-operator/pkg/awsclient"
+	"github.com/openshift/aws-account-operator/pkg/config/schema"
+	"github.com/openshift/aws-account-operator/pkg/controller/utils"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	batchControllerName  = "accountvalidationbatch"
+	batchRunInterval     = 1 * time.Hour
+	batchReportName      = "fleet"
+	batchReportNamespace = awsv1alpha1.AccountCrNamespace
+)
+
+var driftTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "aao_validation_drift_total",
+	Help: "Number of AWS accounts or Account CRs found out of line by the most recent fleet-wide validation pass, by drift type.",
+}, []string{"type"})
+
+func init() {
+	metrics.Registry.MustRegister(driftTotal)
+}
+
+// BatchValidateAccounts periodically enumerates AWS Organizations directly,
+// instead of reconciling one Account CR at a time, and produces a single
+// fleet-wide drift report. It still issues one ListTagsForResource call per
+// account found (same as the per-Account reconciler), but it replaces that
+// reconciler's ListParents call per account per requeue with a constant
+// number of ListAccountsForParent/ListOrganizationalUnitsForParent calls per
+// OU, and runs once per batchRunInterval rather than once per Account watch
+// event. Like the per-Account reconciler, it skips CCS (IsBYOC) accounts and
+// accounts still owned by the account pool, since those are expected to live
+// outside poolOU by design rather than representing drift.
+type BatchValidateAccounts struct {
+	Client           client.Client
+	awsClientBuilder awsclient.IBuilder
+	shardName        string
+}
+
+// AddBatchValidation registers the batch validation pass as a manager
+// Runnable that runs on its own ticker rather than in response to a watch
+// event, since it isn't keyed to any single object.
+func AddBatchValidation(mgr manager.Manager) error {
+	return mgr.Add(newBatchReconciler(mgr))
+}
+
+func newBatchReconciler(mgr manager.Manager) *BatchValidateAccounts {
+	reconciler := &BatchValidateAccounts{
+		Client:           utils.NewClientWithMetricsOrDie(log, mgr, batchControllerName),
+		awsClientBuilder: &awsclient.Builder{},
+	}
+
+	configMap, err := utils.GetOperatorConfigMap(reconciler.Client)
+	if err != nil {
+		log.Error(err, "failed retrieving configmap")
+	} else if hiveName, ok := configMap.Data["shard-name"]; ok {
+		reconciler.shardName = hiveName
+	}
+
+	return reconciler
+}
+
+// Start implements manager.Runnable. It runs the batch validation pass every
+// batchRunInterval until stopCh is closed.
+func (b *BatchValidateAccounts) Start(stopCh <-chan struct{}) error {
+	ticker := time.NewTicker(batchRunInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.runOnce(context.TODO()); err != nil {
+			log.Error(err, "Batch account validation pass failed")
+		}
+
+		select {
+		case <-stopCh:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *BatchValidateAccounts) runOnce(ctx context.Context) error {
+	cm, err := utils.GetOperatorConfigMap(b.Client)
+	if err != nil {
+		return err
+	}
+	cfg, err := schema.Load(cm)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := b.awsClientBuilder.GetClient(batchControllerName, b.Client, awsclient.NewAwsClientInput{
+		AwsRegion:  config.GetDefaultRegion(),
+		SecretName: utils.AwsSecretName,
+		NameSpace:  awsv1alpha1.AccountCrNamespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	awsAccounts, err := listAccountsUnderOU(awsClient, cfg.Root)
+	if err != nil {
+		return err
+	}
+
+	var crs awsv1alpha1.AccountList
+	if err := b.Client.List(ctx, &crs, client.InNamespace(awsv1alpha1.AccountCrNamespace)); err != nil {
+		return err
+	}
+
+	drift := diffAccounts(awsClient, awsAccounts, crs.Items, cfg.ShardName, cfg.Root)
+
+	counts := map[awsv1alpha1.DriftType]int{}
+	for _, d := range drift {
+		counts[d.Type]++
+	}
+	for _, t := range []awsv1alpha1.DriftType{
+		awsv1alpha1.DriftWrongOU,
+		awsv1alpha1.DriftMissingTag,
+		awsv1alpha1.DriftIncorrectOwnerTag,
+		awsv1alpha1.DriftOrphanedAccount,
+		awsv1alpha1.DriftOrphanedCR,
+	} {
+		driftTotal.WithLabelValues(string(t)).Set(float64(counts[t]))
+	}
+
+	return b.recordReport(ctx, cfg.Root, len(awsAccounts), drift)
+}
+
+// ouQueueEntry tracks an OU still to be scanned and its depth below poolOU,
+// for the breadth-first walk in listAccountsUnderOU.
+type ouQueueEntry struct {
+	id    string
+	depth int
+}
+
+// ouAccount pairs an AWS account with the ID of the OU it was found directly
+// parented under, so callers can tell "directly under poolOU" apart from
+// "found in a nested sub-OU" without an extra AWS call.
+type ouAccount struct {
+	account  *organizations.Account
+	originOU string
+}
+
+// listAccountsUnderOU enumerates every AWS account parented directly or
+// transitively under poolOU, walking sub-OUs with
+// ListOrganizationalUnitsForParent. The walk is breadth-first and
+// cycle/depth-guarded the same way ParentsTillPredicate guards its upward
+// walk, rather than recursing unboundedly over a tree an operator doesn't
+// control the shape of.
+func listAccountsUnderOU(client awsclient.Client, poolOU string) ([]ouAccount, error) {
+	var accounts []ouAccount
+	visited := map[string]struct{}{poolOU: {}}
+	queue := []ouQueueEntry{{id: poolOU, depth: 0}}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		err := client.ListAccountsForParentPages(&organizations.ListAccountsForParentInput{
+			ParentId: aws.String(cur.id),
+		}, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+			for _, a := range page.Accounts {
+				accounts = append(accounts, ouAccount{account: a, originOU: cur.id})
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if cur.depth >= defaultMaxOUDepth {
+			return nil, ErrMaxOUDepthExceeded
+		}
+
+		var childOUs []string
+		err = client.ListOrganizationalUnitsForParentPages(&organizations.ListOrganizationalUnitsForParentInput{
+			ParentId: aws.String(cur.id),
+		}, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+			for _, ou := range page.OrganizationalUnits {
+				childOUs = append(childOUs, *ou.Id)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, childOU := range childOUs {
+			if _, seen := visited[childOU]; seen {
+				return nil, ErrParentCycleDetected
+			}
+			visited[childOU] = struct{}{}
+			queue = append(queue, ouQueueEntry{id: childOU, depth: cur.depth + 1})
+		}
+	}
+
+	return accounts, nil
+}
+
+// diffAccounts cross-references the AWS accounts found under the pool OU
+// against the fleet's Account CRs, reporting accounts with no CR, CRs with no
+// AWS account, accounts found outside poolOU itself, and tag drift on
+// accounts that do match. Like the per-Account reconciler, it excludes CCS
+// (IsBYOC) accounts and accounts still owned by the account pool, since those
+// are expected to live outside poolOU by design, not drift.
+func diffAccounts(awsClient awsclient.Client, awsAccounts []ouAccount, crs []awsv1alpha1.Account, shardName, poolOU string) []awsv1alpha1.AccountDrift {
+	crByAccountID := map[string]awsv1alpha1.Account{}
+	excluded := map[string]struct{}{}
+	for _, cr := range crs {
+		if cr.Spec.AwsAccountID == "" {
+			continue
+		}
+		if cr.IsBYOC() || cr.IsOwnedByAccountPool() {
+			excluded[cr.Spec.AwsAccountID] = struct{}{}
+			continue
+		}
+		crByAccountID[cr.Spec.AwsAccountID] = cr
+	}
+
+	var drift []awsv1alpha1.AccountDrift
+	seen := map[string]struct{}{}
+
+	for _, oa := range awsAccounts {
+		acct := oa.account
+		id := aws.StringValue(acct.Id)
+		seen[id] = struct{}{}
+		if _, skip := excluded[id]; skip {
+			continue
+		}
+
+		cr, ok := crByAccountID[id]
+		if !ok {
+			drift = append(drift, awsv1alpha1.AccountDrift{
+				AwsAccountID: id,
+				Type:         awsv1alpha1.DriftOrphanedAccount,
+				Detail:       "AWS account has no matching Account CR",
+			})
+			continue
+		}
+
+		if oa.originOU != poolOU {
+			drift = append(drift, awsv1alpha1.AccountDrift{
+				AwsAccountID: id, AccountCRName: cr.Name,
+				Type: awsv1alpha1.DriftWrongOU, Detail: "Account found under a sub-OU rather than directly under the pool OU",
+			})
+		}
+
+		tagErr := ValidateAccountTags(awsClient, acct.Id, shardName, false, nil)
+		if tagErr == nil {
+			continue
+		}
+		validationErr, ok := tagErr.(*AccountValidationError)
+		if !ok {
+			continue
+		}
+		switch validationErr.Type {
+		case MissingTag:
+			drift = append(drift, awsv1alpha1.AccountDrift{
+				AwsAccountID: id, AccountCRName: cr.Name,
+				Type: awsv1alpha1.DriftMissingTag, Detail: validationErr.Error(),
+			})
+		case IncorrectOwnerTag:
+			drift = append(drift, awsv1alpha1.AccountDrift{
+				AwsAccountID: id, AccountCRName: cr.Name,
+				Type: awsv1alpha1.DriftIncorrectOwnerTag, Detail: validationErr.Error(),
+			})
+		}
+	}
+
+	for id, cr := range crByAccountID {
+		if _, ok := seen[id]; !ok {
+			drift = append(drift, awsv1alpha1.AccountDrift{
+				AwsAccountID: id, AccountCRName: cr.Name,
+				Type:   awsv1alpha1.DriftOrphanedCR,
+				Detail: "Account CR has no matching AWS account under the pool OU",
+			})
+		}
+	}
+
+	return drift
+}
+
+// recordReport upserts the singleton AccountValidationReport with the results
+// of this pass.
+func (b *BatchValidateAccounts) recordReport(ctx context.Context, poolOU string, accountsScanned int, drift []awsv1alpha1.AccountDrift) error {
+	report := &awsv1alpha1.AccountValidationReport{}
+	key := client.ObjectKey{Name: batchReportName, Namespace: batchReportNamespace}
+	if err := b.Client.Get(ctx, key, report); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		report = &awsv1alpha1.AccountValidationReport{}
+		report.Name = batchReportName
+		report.Namespace = batchReportNamespace
+		report.Spec.PoolOU = poolOU
+		if err := b.Client.Create(ctx, report); err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+	report.Status.LastRunTime = &now
+	report.Status.AccountsScanned = accountsScanned
+	report.Status.Drift = drift
+
+	return b.Client.Status().Update(ctx, report)
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
+)
+
+// fakeTagClient answers ListTagsForResource from a pre-baked owner-tag map,
+// reporting no tag at all for an account absent from it. It fails every
+// other method - the only one diffAccounts exercises through
+// ValidateAccountTags.
+type fakeTagClient struct {
+	ownerTagOf map[string]string
+}
+
+func (f *fakeTagClient) ListTagsForResource(input *organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
+	owner, ok := f.ownerTagOf[aws.StringValue(input.ResourceId)]
+	if !ok {
+		return &organizations.ListTagsForResourceOutput{}, nil
+	}
+	return &organizations.ListTagsForResourceOutput{
+		Tags: []*organizations.Tag{{Key: aws.String(ownerKey), Value: aws.String(owner)}},
+	}, nil
+}
+
+func (f *fakeTagClient) ListParentsPages(*organizations.ListParentsInput, func(*organizations.ListParentsOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagClient) MoveAccount(*organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeTagClient) TagResource(*organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeTagClient) ListAccountsForParentPages(*organizations.ListAccountsForParentInput, func(*organizations.ListAccountsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagClient) ListOrganizationalUnitsForParentPages(*organizations.ListOrganizationalUnitsForParentInput, func(*organizations.ListOrganizationalUnitsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagClient) SearchProvisionedProducts(*servicecatalog.SearchProvisionedProductsInput) (*servicecatalog.SearchProvisionedProductsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeTagClient) TerminateProvisionedProduct(*servicecatalog.TerminateProvisionedProductInput) (*servicecatalog.TerminateProvisionedProductOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestDiffAccountsClassifiesDrift(t *testing.T) {
+	const shardName = "shard-1"
+	const poolOU = "ou-pool"
+
+	awsAccounts := []ouAccount{
+		{account: &organizations.Account{Id: aws.String("111111111111")}, originOU: poolOU}, // matches, correctly tagged
+		{account: &organizations.Account{Id: aws.String("222222222222")}, originOU: poolOU}, // matches, missing tag
+		{account: &organizations.Account{Id: aws.String("333333333333")}, originOU: poolOU}, // matches, wrong owner
+		{account: &organizations.Account{Id: aws.String("444444444444")}, originOU: poolOU}, // no CR - orphaned account
+		{account: &organizations.Account{Id: aws.String("666666666666")}, originOU: "ou-sub"}, // matches, but under a sub-OU
+	}
+	crs := []awsv1alpha1.Account{
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "111111111111"}},
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "222222222222"}},
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "333333333333"}},
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "555555555555"}}, // no AWS account - orphaned CR
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "666666666666"}},
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "777777777777", IsBYOC: true}},         // BYOC - excluded, not an orphaned CR
+		{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "888888888888", AccountPool: "legacy"}}, // still pool-owned - excluded
+	}
+	client := &fakeTagClient{ownerTagOf: map[string]string{
+		"111111111111": shardName,
+		"333333333333": "someone-else",
+		"666666666666": shardName,
+	}}
+
+	drift := diffAccounts(client, awsAccounts, crs, shardName, poolOU)
+
+	byType := map[awsv1alpha1.DriftType]int{}
+	for _, d := range drift {
+		byType[d.Type]++
+	}
+
+	want := map[awsv1alpha1.DriftType]int{
+		awsv1alpha1.DriftOrphanedAccount:   1,
+		awsv1alpha1.DriftMissingTag:        1,
+		awsv1alpha1.DriftIncorrectOwnerTag: 1,
+		awsv1alpha1.DriftOrphanedCR:        1,
+		awsv1alpha1.DriftWrongOU:           1,
+	}
+	for driftType, count := range want {
+		if byType[driftType] != count {
+			t.Errorf("drift[%s] = %d, want %d (drift: %+v)", driftType, byType[driftType], count, drift)
+		}
+	}
+	if len(drift) != len(want) {
+		t.Errorf("len(drift) = %d, want %d (drift: %+v)", len(drift), len(want), drift)
+	}
+}
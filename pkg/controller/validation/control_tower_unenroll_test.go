@@ -0,0 +1,97 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	awsv1alpha1 "github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
+)
+
+// fakeCTClient answers ListParentsPages from a pre-baked chain of parents
+// (like fakeOrgClient) and SearchProvisionedProducts/TerminateProvisionedProduct
+// from pre-baked responses, to drive unenrollFromControlTower. It fails every
+// other method.
+type fakeCTClient struct {
+	parentOf     map[string]string
+	products     []*servicecatalog.ProvisionedProductAttribute
+	searchErr    error
+	terminateErr error
+	terminated   []string
+}
+
+func (f *fakeCTClient) ListParentsPages(input *organizations.ListParentsInput, fn func(*organizations.ListParentsOutput, bool) bool) error {
+	parent, ok := f.parentOf[aws.StringValue(input.ChildId)]
+	if !ok {
+		fn(&organizations.ListParentsOutput{}, true)
+		return nil
+	}
+	fn(&organizations.ListParentsOutput{Parents: []*organizations.Parent{{Id: aws.String(parent)}}}, true)
+	return nil
+}
+
+func (f *fakeCTClient) SearchProvisionedProducts(*servicecatalog.SearchProvisionedProductsInput) (*servicecatalog.SearchProvisionedProductsOutput, error) {
+	if f.searchErr != nil {
+		return nil, f.searchErr
+	}
+	return &servicecatalog.SearchProvisionedProductsOutput{ProvisionedProducts: f.products}, nil
+}
+
+func (f *fakeCTClient) TerminateProvisionedProduct(input *servicecatalog.TerminateProvisionedProductInput) (*servicecatalog.TerminateProvisionedProductOutput, error) {
+	if f.terminateErr != nil {
+		return nil, f.terminateErr
+	}
+	f.terminated = append(f.terminated, aws.StringValue(input.ProvisionedProductId))
+	return &servicecatalog.TerminateProvisionedProductOutput{}, nil
+}
+
+func (f *fakeCTClient) MoveAccount(*organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeCTClient) ListTagsForResource(*organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeCTClient) TagResource(*organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeCTClient) ListAccountsForParentPages(*organizations.ListAccountsForParentInput, func(*organizations.ListAccountsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeCTClient) ListOrganizationalUnitsForParentPages(*organizations.ListOrganizationalUnitsForParentInput, func(*organizations.ListOrganizationalUnitsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+
+func TestValidateAccountOUUnenrollsFromControlTower(t *testing.T) {
+	client := &fakeCTClient{
+		parentOf: map[string]string{"111111111111": "ou-ct"},
+		products: []*servicecatalog.ProvisionedProductAttribute{{Id: aws.String("pp-1")}},
+	}
+	account := awsv1alpha1.Account{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "111111111111"}}
+
+	err := (&ValidateAccount{}).ValidateAccountOU(client, account, "ou-pool", []string{"ou-ct"}, true)
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != ControlTowerUnenrollPending {
+		t.Fatalf("err = %v, want ControlTowerUnenrollPending", err)
+	}
+	if want := []string{"pp-1"}; !equalStrings(client.terminated, want) {
+		t.Fatalf("terminated = %v, want %v", client.terminated, want)
+	}
+}
+
+func TestValidateAccountOUReportsUnenrollFailureDistinctly(t *testing.T) {
+	client := &fakeCTClient{
+		parentOf:  map[string]string{"111111111111": "ou-ct"},
+		searchErr: errors.New("boom"),
+	}
+	account := awsv1alpha1.Account{Spec: awsv1alpha1.AccountSpec{AwsAccountID: "111111111111"}}
+
+	err := (&ValidateAccount{}).ValidateAccountOU(client, account, "ou-pool", []string{"ou-ct"}, true)
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != ControlTowerUnenrollFailed {
+		t.Fatalf("err = %v, want ControlTowerUnenrollFailed", err)
+	}
+}
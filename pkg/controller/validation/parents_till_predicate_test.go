@@ -0,0 +1,125 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// fakeOrgClient is a minimal awsclient.Client stub that answers
+// ListParentsPages from a pre-baked chain of parents, one per child ID, and
+// fails every other method - the only one ParentsTillPredicate calls.
+type fakeOrgClient struct {
+	parentOf map[string]string
+	calls    int
+}
+
+func (f *fakeOrgClient) ListParentsPages(input *organizations.ListParentsInput, fn func(*organizations.ListParentsOutput, bool) bool) error {
+	f.calls++
+	parent, ok := f.parentOf[aws.StringValue(input.ChildId)]
+	if !ok {
+		fn(&organizations.ListParentsOutput{}, true)
+		return nil
+	}
+	fn(&organizations.ListParentsOutput{Parents: []*organizations.Parent{{Id: aws.String(parent)}}}, true)
+	return nil
+}
+
+func (f *fakeOrgClient) MoveAccount(*organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeOrgClient) ListTagsForResource(*organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeOrgClient) TagResource(*organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeOrgClient) ListAccountsForParentPages(*organizations.ListAccountsForParentInput, func(*organizations.ListAccountsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeOrgClient) ListOrganizationalUnitsForParentPages(*organizations.ListOrganizationalUnitsForParentInput, func(*organizations.ListOrganizationalUnitsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeOrgClient) SearchProvisionedProducts(*servicecatalog.SearchProvisionedProductsInput) (*servicecatalog.SearchProvisionedProductsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeOrgClient) TerminateProvisionedProduct(*servicecatalog.TerminateProvisionedProductInput) (*servicecatalog.TerminateProvisionedProductOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestParentsTillPredicateFindsMatch(t *testing.T) {
+	client := &fakeOrgClient{parentOf: map[string]string{
+		"111111111111": "ou-1",
+		"ou-1":         "ou-root",
+	}}
+
+	found, path, err := ParentsTillPredicate("111111111111", client, func(s string) bool { return s == "ou-root" }, defaultMaxOUDepth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !found {
+		t.Fatal("expected predicate to match ou-root")
+	}
+	if want := []string{"ou-1", "ou-root"}; !equalStrings(path, want) {
+		t.Fatalf("path = %v, want %v", path, want)
+	}
+}
+
+func TestParentsTillPredicateExhaustsTree(t *testing.T) {
+	client := &fakeOrgClient{parentOf: map[string]string{
+		"111111111111": "ou-1",
+	}}
+
+	found, _, err := ParentsTillPredicate("111111111111", client, func(s string) bool { return s == "ou-never" }, defaultMaxOUDepth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found {
+		t.Fatal("expected no match once the tree is exhausted")
+	}
+}
+
+func TestParentsTillPredicateDetectsCycle(t *testing.T) {
+	client := &fakeOrgClient{parentOf: map[string]string{
+		"111111111111": "ou-1",
+		"ou-1":         "ou-2",
+		"ou-2":         "ou-1",
+	}}
+
+	_, _, err := ParentsTillPredicate("111111111111", client, func(s string) bool { return s == "ou-root" }, defaultMaxOUDepth)
+	if !errors.Is(err, ErrParentCycleDetected) {
+		t.Fatalf("err = %v, want ErrParentCycleDetected", err)
+	}
+}
+
+func TestParentsTillPredicateRespectsMaxDepth(t *testing.T) {
+	parentOf := map[string]string{"111111111111": ouName(0)}
+	for i := 0; i < defaultMaxOUDepth+2; i++ {
+		parentOf[ouName(i)] = ouName(i + 1)
+	}
+	client := &fakeOrgClient{parentOf: parentOf}
+
+	_, _, err := ParentsTillPredicate("111111111111", client, func(s string) bool { return false }, defaultMaxOUDepth)
+	if !errors.Is(err, ErrMaxOUDepthExceeded) {
+		t.Fatalf("err = %v, want ErrMaxOUDepthExceeded", err)
+	}
+}
+
+func ouName(i int) string {
+	return "ou-" + string(rune('a'+i))
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// fakeTagWriteClient backs ValidateAccountTags' remediation branches: it
+// answers ListTagsForResource from a pre-baked owner tag and records every
+// TagResource call it receives. It fails every other method.
+type fakeTagWriteClient struct {
+	hasTag     bool
+	ownerTag   string
+	taggedWith []string
+}
+
+func (f *fakeTagWriteClient) ListTagsForResource(*organizations.ListTagsForResourceInput) (*organizations.ListTagsForResourceOutput, error) {
+	if !f.hasTag {
+		return &organizations.ListTagsForResourceOutput{}, nil
+	}
+	return &organizations.ListTagsForResourceOutput{
+		Tags: []*organizations.Tag{{Key: aws.String(ownerKey), Value: aws.String(f.ownerTag)}},
+	}, nil
+}
+
+func (f *fakeTagWriteClient) TagResource(input *organizations.TagResourceInput) (*organizations.TagResourceOutput, error) {
+	f.taggedWith = append(f.taggedWith, aws.StringValue(input.Tags[0].Value))
+	return &organizations.TagResourceOutput{}, nil
+}
+
+func (f *fakeTagWriteClient) ListParentsPages(*organizations.ListParentsInput, func(*organizations.ListParentsOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagWriteClient) MoveAccount(*organizations.MoveAccountInput) (*organizations.MoveAccountOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeTagWriteClient) ListAccountsForParentPages(*organizations.ListAccountsForParentInput, func(*organizations.ListAccountsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagWriteClient) ListOrganizationalUnitsForParentPages(*organizations.ListOrganizationalUnitsForParentInput, func(*organizations.ListOrganizationalUnitsForParentOutput, bool) bool) error {
+	return errors.New("not implemented")
+}
+func (f *fakeTagWriteClient) SearchProvisionedProducts(*servicecatalog.SearchProvisionedProductsInput) (*servicecatalog.SearchProvisionedProductsOutput, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeTagWriteClient) TerminateProvisionedProduct(*servicecatalog.TerminateProvisionedProductInput) (*servicecatalog.TerminateProvisionedProductOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestValidateAccountTagsFixesMissingTag(t *testing.T) {
+	client := &fakeTagWriteClient{}
+
+	err := ValidateAccountTags(client, aws.String("111111111111"), "shard-1", true, nil)
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != TagFixed {
+		t.Fatalf("err = %v, want TagFixed", err)
+	}
+	if want := []string{"shard-1"}; !equalStrings(client.taggedWith, want) {
+		t.Fatalf("taggedWith = %v, want %v", client.taggedWith, want)
+	}
+}
+
+func TestValidateAccountTagsFixesIncorrectTag(t *testing.T) {
+	client := &fakeTagWriteClient{hasTag: true, ownerTag: "shard-0"}
+
+	err := ValidateAccountTags(client, aws.String("111111111111"), "shard-1", true, nil)
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != TagFixed {
+		t.Fatalf("err = %v, want TagFixed", err)
+	}
+	if want := []string{"shard-1"}; !equalStrings(client.taggedWith, want) {
+		t.Fatalf("taggedWith = %v, want %v", client.taggedWith, want)
+	}
+}
+
+func TestValidateAccountTagsLeavesHandoffOwnerAlone(t *testing.T) {
+	client := &fakeTagWriteClient{hasTag: true, ownerTag: "shard-0"}
+
+	err := ValidateAccountTags(client, aws.String("111111111111"), "shard-1", true, []string{"shard-0"})
+
+	ve, ok := err.(*AccountValidationError)
+	if !ok || ve.Type != IncorrectOwnerTag {
+		t.Fatalf("err = %v, want IncorrectOwnerTag", err)
+	}
+	if len(client.taggedWith) != 0 {
+		t.Fatalf("expected no TagResource call during a recognized hand-off, got %v", client.taggedWith)
+	}
+}